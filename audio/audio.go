@@ -0,0 +1,160 @@
+// Package audio provides a pluggable sound subsystem for the DVD
+// screensaver: short bounce blips per edge, a distinct corner-hit sound,
+// and optional looping background music, built on ebiten's audio package.
+package audio
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"io"
+	"log"
+
+	"github.com/hajimehoshi/ebiten/v2/audio"
+	"github.com/hajimehoshi/ebiten/v2/audio/wav"
+)
+
+// sampleRate is the rate of the single shared audio.Context, and must
+// match the rate every embedded asset was authored at.
+const sampleRate = 48000
+
+//go:embed assets/*.wav
+var assetFS embed.FS
+
+// Edge identifies which screen edge a logo bounced off of, so the bounce
+// sound's pitch can vary by edge.
+type Edge int
+
+const (
+	EdgeLeft Edge = iota
+	EdgeRight
+	EdgeTop
+	EdgeBottom
+)
+
+// Player plays bounce and corner-hit sound effects plus optional looping
+// background music on a single shared audio.Context.
+type Player struct {
+	ctx *audio.Context
+
+	bouncePCM    map[Edge][]byte
+	cornerHitPCM []byte
+	music        *audio.Player
+	muted        bool
+}
+
+// New decodes all embedded sound assets and returns a ready Player. It
+// returns an error if any asset fails to decode; callers should log the
+// error and continue running without audio rather than treating it as
+// fatal.
+func New() (*Player, error) {
+	ctx := audio.NewContext(sampleRate)
+
+	p := &Player{
+		ctx:       ctx,
+		bouncePCM: make(map[Edge][]byte, 4),
+	}
+
+	bounceAssets := map[Edge]string{
+		EdgeLeft:   "assets/bounce_left.wav",
+		EdgeRight:  "assets/bounce_right.wav",
+		EdgeTop:    "assets/bounce_top.wav",
+		EdgeBottom: "assets/bounce_bottom.wav",
+	}
+	for edge, name := range bounceAssets {
+		pcm, err := decodeWAV(name)
+		if err != nil {
+			return nil, fmt.Errorf("audio: decode %s: %w", name, err)
+		}
+		p.bouncePCM[edge] = pcm
+	}
+
+	cornerPCM, err := decodeWAV("assets/corner_hit.wav")
+	if err != nil {
+		return nil, fmt.Errorf("audio: decode corner hit: %w", err)
+	}
+	p.cornerHitPCM = cornerPCM
+
+	musicPCM, err := decodeWAV("assets/music_loop.wav")
+	if err != nil {
+		return nil, fmt.Errorf("audio: decode music: %w", err)
+	}
+	loop := audio.NewInfiniteLoopF32(bytes.NewReader(musicPCM), int64(len(musicPCM)))
+	musicPlayer, err := ctx.NewPlayerF32(loop)
+	if err != nil {
+		return nil, fmt.Errorf("audio: create music player: %w", err)
+	}
+	p.music = musicPlayer
+
+	return p, nil
+}
+
+// decodeWAV loads and fully decodes an embedded WAV asset to raw float32
+// PCM bytes, so it can be replayed from memory without re-decoding.
+func decodeWAV(name string) ([]byte, error) {
+	f, err := assetFS.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	stream, err := wav.DecodeF32(f)
+	if err != nil {
+		return nil, err
+	}
+	return io.ReadAll(stream)
+}
+
+// play starts a fresh one-shot player for the given decoded PCM data. A
+// new player is created per call since ebiten's audio.Player can only be
+// played through once.
+func (p *Player) play(pcm []byte) {
+	player, err := p.ctx.NewPlayerF32(bytes.NewReader(pcm))
+	if err != nil {
+		log.Printf("audio: failed to start playback: %v", err)
+		return
+	}
+	player.Play()
+}
+
+// PlayBounce plays the bounce blip for the given edge. A nil Player is a
+// no-op, so callers can keep a Player injected even when audio init
+// failed.
+func (p *Player) PlayBounce(edge Edge) {
+	if p == nil {
+		return
+	}
+	if pcm, ok := p.bouncePCM[edge]; ok {
+		p.play(pcm)
+	}
+}
+
+// PlayCornerHit plays the distinct sound used when a logo bounces off two
+// edges in the same tick.
+func (p *Player) PlayCornerHit() {
+	if p == nil {
+		return
+	}
+	p.play(p.cornerHitPCM)
+}
+
+// PlayMusic starts the looping background music, unless muted.
+func (p *Player) PlayMusic() {
+	if p == nil || p.muted {
+		return
+	}
+	p.music.Play()
+}
+
+// ToggleMute mutes or unmutes the background music.
+func (p *Player) ToggleMute() {
+	if p == nil {
+		return
+	}
+	p.muted = !p.muted
+	if p.muted {
+		p.music.Pause()
+	} else {
+		p.music.Play()
+	}
+}
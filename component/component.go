@@ -0,0 +1,47 @@
+// Package component defines the plain-data components attached to
+// entities in the DVD screensaver's entity-component-system, plus the
+// World that owns them. Components hold no behavior; systems in the
+// sibling system package read and mutate them each tick.
+package component
+
+import (
+	"image/color"
+
+	"github.com/HugeFrog24/dvd-screensaver-go/sprite"
+)
+
+// EntityID identifies a single entity in a World.
+type EntityID int
+
+// Position is an entity's top-left location on screen.
+type Position struct {
+	X, Y float64
+}
+
+// Velocity is an entity's per-tick movement.
+type Velocity struct {
+	VX, VY float64
+}
+
+// Renderable is the sprite drawn at an entity's Position.
+type Renderable struct {
+	Sprite *sprite.Sprite
+}
+
+// Bouncer marks an entity as bouncing off the screen edges and
+// colliding with other bouncers, using the given bounding box.
+type Bouncer struct {
+	Width, Height int
+}
+
+// ColorCycler tracks an entity's current color within a fixed palette,
+// advanced each time it bounces or collides.
+type ColorCycler struct {
+	Colors []color.RGBA
+	Index  int
+}
+
+// PlayerControlled marks an entity as currently grabbed by pointer
+// input, so MovementSystem and CollisionSystem leave it alone while
+// InputSystem drives its Position directly.
+type PlayerControlled struct{}
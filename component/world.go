@@ -0,0 +1,45 @@
+package component
+
+// World owns every entity and its components. Components are stored in
+// per-type maps keyed by EntityID; an entity is just an ID with some
+// subset of these maps populated, so adding a new entity kind only
+// means populating a different subset of maps, not touching existing
+// systems.
+type World struct {
+	nextID EntityID
+	order  []EntityID // creation order, for stable draw/iteration order
+
+	Positions         map[EntityID]*Position
+	Velocities        map[EntityID]*Velocity
+	Renderables       map[EntityID]*Renderable
+	Bouncers          map[EntityID]*Bouncer
+	ColorCyclers      map[EntityID]*ColorCycler
+	PlayerControlleds map[EntityID]*PlayerControlled
+}
+
+// NewWorld creates an empty World.
+func NewWorld() *World {
+	return &World{
+		Positions:         make(map[EntityID]*Position),
+		Velocities:        make(map[EntityID]*Velocity),
+		Renderables:       make(map[EntityID]*Renderable),
+		Bouncers:          make(map[EntityID]*Bouncer),
+		ColorCyclers:      make(map[EntityID]*ColorCycler),
+		PlayerControlleds: make(map[EntityID]*PlayerControlled),
+	}
+}
+
+// NewEntity allocates and returns a fresh EntityID. It attaches no
+// components; callers populate whichever component maps the entity
+// needs.
+func (w *World) NewEntity() EntityID {
+	id := w.nextID
+	w.nextID++
+	w.order = append(w.order, id)
+	return id
+}
+
+// Entities returns every entity ID in creation order.
+func (w *World) Entities() []EntityID {
+	return w.order
+}
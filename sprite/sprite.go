@@ -0,0 +1,70 @@
+// Package sprite holds the renderable frame-set used for every drawn
+// entity in the DVD screensaver, generalizing a single static image
+// into a small animation: a fixed-size PNG/GIF still works as a
+// one-frame Sprite, while a multi-frame atlas cycles through its
+// frames over time. The model is loosely inspired by OpenDiablo2's
+// Sprite/SpriteFrame pair, simplified down to what this screensaver
+// needs.
+package sprite
+
+import (
+	"image/color"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// Sprite is an ordered set of frames drawn one at a time, advancing to
+// the next frame every FrameDuration. A single-frame Sprite never
+// advances and behaves like a static image.
+type Sprite struct {
+	Frames        []*ebiten.Image
+	FrameDuration time.Duration
+	Blend         bool
+
+	startedAt time.Time
+}
+
+// New creates a Sprite over frames, animating at one frame every
+// frameDuration. A frameDuration of zero (or a single frame) disables
+// animation; Draw always shows Frames[0]. Blend selects an additive
+// blend mode, useful for glow-style atlases over the default opaque
+// draw.
+func New(frames []*ebiten.Image, frameDuration time.Duration, blend bool) *Sprite {
+	return &Sprite{
+		Frames:        frames,
+		FrameDuration: frameDuration,
+		Blend:         blend,
+		startedAt:     time.Now(),
+	}
+}
+
+// currentFrame returns the frame to show right now, based on wall-clock
+// time elapsed since the Sprite was created.
+func (s *Sprite) currentFrame() *ebiten.Image {
+	if len(s.Frames) == 0 {
+		return nil
+	}
+	if len(s.Frames) == 1 || s.FrameDuration <= 0 {
+		return s.Frames[0]
+	}
+	index := int(time.Since(s.startedAt)/s.FrameDuration) % len(s.Frames)
+	return s.Frames[index]
+}
+
+// Draw renders the current frame at (x, y) on target, tinted by tint.
+// Pass color.RGBA{255, 255, 255, 255} for an untinted draw.
+func (s *Sprite) Draw(target *ebiten.Image, x, y float64, tint color.RGBA) {
+	frame := s.currentFrame()
+	if frame == nil {
+		return
+	}
+
+	opts := &ebiten.DrawImageOptions{}
+	opts.GeoM.Translate(x, y)
+	opts.ColorScale.ScaleWithColor(tint)
+	if s.Blend {
+		opts.Blend = ebiten.BlendLighter
+	}
+	target.DrawImage(frame, opts)
+}
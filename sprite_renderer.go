@@ -0,0 +1,100 @@
+package main
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+
+	"github.com/HugeFrog24/dvd-screensaver-go/sprite"
+)
+
+// defaultSpriteFrameDuration is how long each frame of a user-supplied
+// atlas is shown before advancing to the next one.
+const defaultSpriteFrameDuration = 120 * time.Millisecond
+
+// SpriteRenderer implements system.LogoRenderer by loading a
+// user-supplied sprite atlas once at startup and handing out the same
+// long-lived, already-animating Sprite for every logo, ignoring the
+// requested width, height, and color since those are baked into the
+// atlas itself. Sharing one Sprite (rather than building a fresh one
+// per call) matters because CreateLogo is also called every time a
+// logo's color cycles on bounce/collision - a fresh Sprite would reset
+// its animation back to frame zero on every such call, so the atlas
+// would rarely animate past its first few frames.
+type SpriteRenderer struct {
+	sprite *sprite.Sprite
+}
+
+// NewSpriteRenderer loads path as a sprite atlas. A .gif is split into
+// one frame per GIF frame; any other image (PNG, etc.) is treated as a
+// single horizontal strip of square frames, each frameWidth wide, where
+// frameWidth is the atlas height - if the atlas isn't an exact multiple
+// of that width it's treated as one single-frame image instead.
+func NewSpriteRenderer(path string, frameDuration time.Duration) (*SpriteRenderer, error) {
+	frames, err := loadSpriteFrames(path)
+	if err != nil {
+		return nil, err
+	}
+	return &SpriteRenderer{sprite: sprite.New(frames, frameDuration, false)}, nil
+}
+
+// CreateLogo implements system.LogoRenderer.
+func (r *SpriteRenderer) CreateLogo(width, height int, _ color.RGBA) *sprite.Sprite {
+	return r.sprite
+}
+
+func loadSpriteFrames(path string) ([]*ebiten.Image, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening sprite atlas: %w", err)
+	}
+	defer f.Close()
+
+	if strings.EqualFold(filepath.Ext(path), ".gif") {
+		g, err := gif.DecodeAll(f)
+		if err != nil {
+			return nil, fmt.Errorf("decoding GIF sprite atlas: %w", err)
+		}
+		frames := make([]*ebiten.Image, 0, len(g.Image))
+		for _, frame := range g.Image {
+			frames = append(frames, ebiten.NewImageFromImage(frame))
+		}
+		return frames, nil
+	}
+
+	img, err := png.Decode(f)
+	if err != nil {
+		return nil, fmt.Errorf("decoding PNG sprite atlas: %w", err)
+	}
+	return splitHorizontalStrip(img), nil
+}
+
+// splitHorizontalStrip slices atlas into square frameWidth-by-height
+// frames laid out left to right, where frameWidth equals the atlas's
+// height. If the width isn't an exact multiple, atlas is returned as a
+// single frame instead of guessing at a layout.
+func splitHorizontalStrip(atlas image.Image) []*ebiten.Image {
+	bounds := atlas.Bounds()
+	frameWidth := bounds.Dy()
+	whole := ebiten.NewImageFromImage(atlas)
+
+	if frameWidth <= 0 || bounds.Dx()%frameWidth != 0 {
+		return []*ebiten.Image{whole}
+	}
+
+	count := bounds.Dx() / frameWidth
+	frames := make([]*ebiten.Image, 0, count)
+	for i := 0; i < count; i++ {
+		rect := image.Rect(i*frameWidth, 0, (i+1)*frameWidth, bounds.Dy())
+		frames = append(frames, whole.SubImage(rect).(*ebiten.Image))
+	}
+	return frames
+}
@@ -0,0 +1,109 @@
+package system
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+
+	"github.com/HugeFrog24/dvd-screensaver-go/component"
+)
+
+// CollisionSystem finds overlapping bouncing entities using a uniform
+// grid spatial hash and resolves each overlap with the standard 2D
+// elastic collision formula for equal masses, cycling both entities'
+// colors and queuing a log event on contact. It is skipped while
+// RewindSystem is scrubbing history or the simulation is paused.
+type CollisionSystem struct {
+	grid *spatialGrid
+}
+
+// NewCollisionSystem creates a CollisionSystem whose spatial hash uses
+// the given cell size, normally the logo width so any two overlapping
+// logos always share or are adjacent to the same cell.
+func NewCollisionSystem(cellSize int) *CollisionSystem {
+	return &CollisionSystem{grid: newSpatialGrid(cellSize)}
+}
+
+// Update implements System.
+func (cs *CollisionSystem) Update(w *component.World, s *Shared) error {
+	if s.RewindActive || s.Paused {
+		return nil
+	}
+
+	ids := make([]component.EntityID, 0, len(w.Bouncers))
+	for _, id := range w.Entities() {
+		if _, dragged := w.PlayerControlleds[id]; dragged {
+			continue
+		}
+		if _, ok := w.Bouncers[id]; !ok {
+			continue
+		}
+		if _, ok := w.Positions[id]; !ok {
+			continue
+		}
+		ids = append(ids, id)
+	}
+
+	cs.grid.rebuild(w, ids)
+	for _, pair := range cs.grid.candidatePairs() {
+		i, j := ids[pair[0]], ids[pair[1]]
+		if !resolveElasticCollision(w, i, j) {
+			continue
+		}
+		cycleColor(w, i, s)
+		cycleColor(w, j, s)
+		s.Events = append(s.Events, Event{Kind: EventCollision, Message: fmt.Sprintf(
+			"[%s] COLLISION: Entities %d and %d bounced off each other", s.Elapsed(), i, j)})
+	}
+
+	return nil
+}
+
+// Draw implements System; CollisionSystem has nothing to draw.
+func (cs *CollisionSystem) Draw(screen *ebiten.Image, w *component.World, s *Shared) {}
+
+// logoRadius approximates a bouncer as a circle for collision purposes,
+// using half the diagonal of its bounding box.
+func logoRadius(b *component.Bouncer) float64 {
+	return math.Hypot(float64(b.Width), float64(b.Height)) / 2
+}
+
+// resolveElasticCollision checks entities i and j for a circular overlap
+// and, if they overlap, swaps the velocity components along the
+// collision normal using the standard 2D elastic collision formula for
+// equal masses: v1' = v1 - ((v1-v2).n)n, v2' = v2 + ((v1-v2).n)n, where
+// n is the collision normal and "." is the dot product. It reports
+// whether a collision was resolved.
+func resolveElasticCollision(w *component.World, i, j component.EntityID) bool {
+	posI, posJ := w.Positions[i], w.Positions[j]
+	velI, velJ := w.Velocities[i], w.Velocities[j]
+	bouncerI, bouncerJ := w.Bouncers[i], w.Bouncers[j]
+
+	centerXi := posI.X + float64(bouncerI.Width)/2
+	centerYi := posI.Y + float64(bouncerI.Height)/2
+	centerXj := posJ.X + float64(bouncerJ.Width)/2
+	centerYj := posJ.Y + float64(bouncerJ.Height)/2
+
+	dx := centerXj - centerXi
+	dy := centerYj - centerYi
+	dist := math.Hypot(dx, dy)
+	combinedRadius := logoRadius(bouncerI) + logoRadius(bouncerJ)
+	if dist == 0 || dist >= combinedRadius {
+		return false
+	}
+
+	nx := dx / dist
+	ny := dy / dist
+
+	relVX := velI.VX - velJ.VX
+	relVY := velI.VY - velJ.VY
+	dot := relVX*nx + relVY*ny
+
+	velI.VX -= dot * nx
+	velI.VY -= dot * ny
+	velJ.VX += dot * nx
+	velJ.VY += dot * ny
+
+	return true
+}
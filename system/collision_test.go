@@ -0,0 +1,65 @@
+package system
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/HugeFrog24/dvd-screensaver-go/component"
+)
+
+func TestCollisionSystemResolvesOverlap(t *testing.T) {
+	w := component.NewWorld()
+	s := newTestShared()
+	a := NewLogoEntity(w, 100, 100, 2, 0, s)
+	b := NewLogoEntity(w, 110, 100, -2, 0, s)
+
+	cs := NewCollisionSystem(s.LogoWidth)
+	if err := cs.Update(w, s); err != nil {
+		t.Fatalf("Update returned error: %v", err)
+	}
+
+	velA, velB := w.Velocities[a], w.Velocities[b]
+	if velA.VX >= 0 || velB.VX <= 0 {
+		t.Fatalf("expected overlapping entities to swap velocity sign along the collision normal, got a=%v b=%v", velA.VX, velB.VX)
+	}
+}
+
+func TestCollisionSystemIgnoresFarApartEntities(t *testing.T) {
+	w := component.NewWorld()
+	s := newTestShared()
+	a := NewLogoEntity(w, 0, 0, 2, 0, s)
+	b := NewLogoEntity(w, 700, 500, -2, 0, s)
+
+	cs := NewCollisionSystem(s.LogoWidth)
+	if err := cs.Update(w, s); err != nil {
+		t.Fatalf("Update returned error: %v", err)
+	}
+
+	if w.Velocities[a].VX != 2 || w.Velocities[b].VX != -2 {
+		t.Fatalf("expected far-apart entities' velocities to stay unchanged")
+	}
+}
+
+// BenchmarkCollisionSystem measures one tick's worth of grid rebuild
+// plus candidate-pair collision resolution, the per-frame cost that
+// must stay within a 1/60s budget for LogoCount to reach into the
+// hundreds.
+func BenchmarkCollisionSystem(b *testing.B) {
+	for _, n := range []int{50, 100, 500} {
+		b.Run(fmt.Sprintf("%dlogos", n), func(b *testing.B) {
+			w := component.NewWorld()
+			s := newTestShared()
+			for i := 0; i < n; i++ {
+				NewRandomLogoEntity(w, s)
+			}
+			cs := NewCollisionSystem(s.LogoWidth)
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if err := cs.Update(w, s); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
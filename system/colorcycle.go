@@ -0,0 +1,55 @@
+package system
+
+import (
+	"github.com/HugeFrog24/dvd-screensaver-go/audio"
+	"github.com/HugeFrog24/dvd-screensaver-go/component"
+)
+
+// cycleColor advances entity id's ColorCycler to the next color in its
+// palette and regenerates its Renderable image via Shared.Renderer.
+func cycleColor(w *component.World, id component.EntityID, s *Shared) {
+	cycler, ok := w.ColorCyclers[id]
+	if !ok {
+		return
+	}
+	cycler.Index = (cycler.Index + 1) % len(cycler.Colors)
+	setRenderedColor(w, id, s, cycler.Index)
+}
+
+// setRenderedColor regenerates entity id's Renderable image for the
+// given palette index, using its Bouncer size if present or the default
+// logo size otherwise.
+func setRenderedColor(w *component.World, id component.EntityID, s *Shared, colorIndex int) {
+	renderable, ok := w.Renderables[id]
+	if !ok {
+		return
+	}
+	cycler, ok := w.ColorCyclers[id]
+	if !ok {
+		return
+	}
+
+	width, height := s.LogoWidth, s.LogoHeight
+	if bouncer, ok := w.Bouncers[id]; ok {
+		width, height = bouncer.Width, bouncer.Height
+	}
+	renderable.Sprite = s.Renderer.CreateLogo(width, height, cycler.Colors[colorIndex])
+	s.NeedsRedraw = true
+}
+
+// edgeName returns a human-readable name for a bounce edge, used in log
+// messages.
+func edgeName(e audio.Edge) string {
+	switch e {
+	case audio.EdgeLeft:
+		return "left"
+	case audio.EdgeRight:
+		return "right"
+	case audio.EdgeTop:
+		return "top"
+	case audio.EdgeBottom:
+		return "bottom"
+	default:
+		return "unknown"
+	}
+}
@@ -0,0 +1,86 @@
+package system
+
+import (
+	"fmt"
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+
+	"github.com/HugeFrog24/dvd-screensaver-go/component"
+)
+
+// DebugOverlaySystem draws everything layered on top of the
+// simulation: the scrolling log panel, the pending fling-vector arrow
+// while a logo is being dragged, the rewind scrub timeline, and the
+// FPS/controls line.
+type DebugOverlaySystem struct {
+	ShowLogPanel bool
+}
+
+// Update implements System; DebugOverlaySystem has nothing to update.
+func (DebugOverlaySystem) Update(w *component.World, s *Shared) error { return nil }
+
+// Draw implements System.
+func (d DebugOverlaySystem) Draw(screen *ebiten.Image, w *component.World, s *Shared) {
+	if d.ShowLogPanel && len(s.LogBuffer) > 0 {
+		logBgColor := color.RGBA{0, 0, 0, 120}
+		vector.DrawFilledRect(screen, 0, float32(s.ScreenHeight-20*MaxLogLines), float32(s.ScreenWidth), float32(20*MaxLogLines), logBgColor, false)
+
+		for i, msg := range s.LogBuffer {
+			if len(msg) > 100 {
+				msg = msg[:97] + "..."
+			}
+			y := s.ScreenHeight - 20*(MaxLogLines-i)
+			ebitenutil.DebugPrintAt(screen, msg, 10, y)
+		}
+	}
+
+	d.drawFlingIndicator(screen, w, s)
+	d.drawRewindTimeline(screen, s)
+
+	pausedSuffix := ""
+	if s.Paused {
+		pausedSuffix = " | PAUSED"
+	}
+	ebitenutil.DebugPrint(screen, fmt.Sprintf(
+		"FPS: %.1f | Logos: %d | Speed: %.1f | [F] fullscreen | [ESC] exit fullscreen | [J] slower | [L] faster | [drag] fling | [right-click] spawn | [hold R] rewind | [M] mute | [P] pause%s",
+		ebiten.CurrentFPS(), len(w.Entities()), s.Speed, pausedSuffix))
+}
+
+func (d DebugOverlaySystem) drawFlingIndicator(screen *ebiten.Image, w *component.World, s *Shared) {
+	if !s.DragActive || (s.DragAvgDX == 0 && s.DragAvgDY == 0) {
+		return
+	}
+	pos, ok := w.Positions[s.DragEntity]
+	if !ok {
+		return
+	}
+
+	width, height := s.LogoWidth, s.LogoHeight
+	if bouncer, ok := w.Bouncers[s.DragEntity]; ok {
+		width, height = bouncer.Width, bouncer.Height
+	}
+
+	startX := float32(pos.X + float64(width)/2)
+	startY := float32(pos.Y + float64(height)/2)
+	const indicatorScale = 6
+	endX := startX + float32(s.DragAvgDX*indicatorScale)
+	endY := startY + float32(s.DragAvgDY*indicatorScale)
+
+	vector.StrokeLine(screen, startX, startY, endX, endY, 2, color.RGBA{255, 255, 255, 220}, false)
+}
+
+func (d DebugOverlaySystem) drawRewindTimeline(screen *ebiten.Image, s *Shared) {
+	if s.RewindBufferLen < 2 {
+		return
+	}
+
+	const barHeight = 4
+	vector.DrawFilledRect(screen, 0, 0, float32(s.ScreenWidth), barHeight, color.RGBA{60, 60, 60, 200}, false)
+
+	progress := float32(s.RewindCursor) / float32(s.RewindBufferLen-1)
+	markerX := progress * float32(s.ScreenWidth)
+	vector.DrawFilledRect(screen, markerX-2, 0, 4, barHeight, color.RGBA{255, 255, 255, 255}, false)
+}
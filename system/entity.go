@@ -0,0 +1,43 @@
+package system
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/HugeFrog24/dvd-screensaver-go/component"
+)
+
+// NewLogoEntity creates a new bouncing, color-cycling logo entity at the
+// given position and velocity, rendered via Shared.Renderer using
+// Shared.Colors as its palette.
+func NewLogoEntity(w *component.World, x, y, vx, vy float64, s *Shared) component.EntityID {
+	id := w.NewEntity()
+	w.Positions[id] = &component.Position{X: x, Y: y}
+	w.Velocities[id] = &component.Velocity{VX: vx, VY: vy}
+	w.Bouncers[id] = &component.Bouncer{Width: s.LogoWidth, Height: s.LogoHeight}
+	w.ColorCyclers[id] = &component.ColorCycler{Colors: s.Colors, Index: 0}
+	w.Renderables[id] = &component.Renderable{Sprite: s.Renderer.CreateLogo(s.LogoWidth, s.LogoHeight, s.Colors[0])}
+	return id
+}
+
+// NewRandomLogoEntity creates a logo entity at a random on-screen
+// position with a random velocity at the current speed, avoiding very
+// slow horizontal or vertical movement so it doesn't look stuck against
+// an edge.
+func NewRandomLogoEntity(w *component.World, s *Shared) component.EntityID {
+	angle := rand.Float64() * 2 * math.Pi
+	vx := math.Cos(angle) * s.Speed
+	vy := math.Sin(angle) * s.Speed
+
+	if math.Abs(vx) < 1.0 {
+		vx = math.Copysign(1.0, vx)
+	}
+	if math.Abs(vy) < 1.0 {
+		vy = math.Copysign(1.0, vy)
+	}
+
+	x := float64(rand.Intn(s.ScreenWidth - s.LogoWidth))
+	y := float64(rand.Intn(s.ScreenHeight - s.LogoHeight))
+
+	return NewLogoEntity(w, x, y, vx, vy, s)
+}
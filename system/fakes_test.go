@@ -0,0 +1,47 @@
+package system
+
+import (
+	"image/color"
+	"io"
+	"log"
+
+	"github.com/HugeFrog24/dvd-screensaver-go/audio"
+	"github.com/HugeFrog24/dvd-screensaver-go/sprite"
+)
+
+// fakeRenderer stands in for a real LogoRenderer in tests; a nil Sprite
+// is fine since these tests never exercise RenderSystem.Draw.
+type fakeRenderer struct{}
+
+func (fakeRenderer) CreateLogo(width, height int, c color.RGBA) *sprite.Sprite { return nil }
+
+// fakeAudio records which sounds were requested without touching a real
+// audio device.
+type fakeAudio struct {
+	bounces     []audio.Edge
+	cornerHits  int
+	musicPlays  int
+	muteToggles int
+}
+
+func (f *fakeAudio) PlayBounce(edge audio.Edge) { f.bounces = append(f.bounces, edge) }
+func (f *fakeAudio) PlayCornerHit()             { f.cornerHits++ }
+func (f *fakeAudio) PlayMusic()                 { f.musicPlays++ }
+func (f *fakeAudio) ToggleMute()                { f.muteToggles++ }
+
+// newTestShared returns a Shared wired up with fakes, sized like the
+// real game.
+func newTestShared() *Shared {
+	return &Shared{
+		Logger:       log.New(io.Discard, "", 0),
+		Renderer:     fakeRenderer{},
+		Audio:        &fakeAudio{},
+		Colors:       []color.RGBA{{255, 0, 0, 255}, {0, 255, 0, 255}},
+		Config:       &Config{MinSpeed: 0.5, MaxSpeed: 10, SpeedStep: 0.5, LogoCount: 1},
+		ScreenWidth:  800,
+		ScreenHeight: 600,
+		LogoWidth:    120,
+		LogoHeight:   60,
+		Speed:        3,
+	}
+}
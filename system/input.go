@@ -0,0 +1,301 @@
+package system
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+
+	"github.com/hajimehoshi/ebiten/v2"
+
+	"github.com/HugeFrog24/dvd-screensaver-go/component"
+)
+
+// dragFlingSampleFrames is how many recent frames of pointer movement
+// are averaged into the velocity imparted when a drag is released.
+const dragFlingSampleFrames = 5
+
+// pointerDelta records one frame's pointer movement while dragging a
+// logo.
+type pointerDelta struct {
+	dx, dy float64
+}
+
+// InputSystem handles keyboard shortcuts (fullscreen, speed, mute) plus
+// mouse/touch grab, drag, fling, and right-click spawning of new logo
+// entities. Pointer handling is skipped while RewindSystem is scrubbing
+// history.
+type InputSystem struct {
+	lastFKeyPressed   bool
+	lastEscKeyPressed bool
+	lastJKeyPressed   bool
+	lastLKeyPressed   bool
+	lastMKeyPressed   bool
+	lastPKeyPressed   bool
+
+	lastMouseLeftPressed  bool
+	lastMouseRightPressed bool
+
+	dragging       bool
+	draggedID      component.EntityID
+	draggedByTouch bool
+	draggedTouchID ebiten.TouchID
+	dragOffsetX    float64
+	dragOffsetY    float64
+	lastDragX      int
+	lastDragY      int
+	dragDeltas     []pointerDelta
+}
+
+// Update implements System.
+func (is *InputSystem) Update(w *component.World, s *Shared) error {
+	is.handleFullscreenKeys(s)
+	is.handleSpeedKeys(w, s)
+	is.handleMuteKey(s)
+	is.handlePauseKey(s)
+
+	if !s.RewindActive {
+		is.handleMouseInput(w, s)
+		is.handleTouchInput(w, s)
+	}
+
+	s.DragActive = is.dragging
+	s.DragEntity = is.draggedID
+	s.DragAvgDX, s.DragAvgDY = is.averageDragDelta()
+
+	return nil
+}
+
+// Draw implements System; InputSystem has nothing to draw.
+func (is *InputSystem) Draw(screen *ebiten.Image, w *component.World, s *Shared) {}
+
+func (is *InputSystem) handleFullscreenKeys(s *Shared) {
+	fKeyPressed := ebiten.IsKeyPressed(ebiten.KeyF)
+	escKeyPressed := ebiten.IsKeyPressed(ebiten.KeyEscape)
+
+	if fKeyPressed && !is.lastFKeyPressed {
+		is.setFullscreen(s, !s.IsFullscreen, "Fullscreen toggled")
+	}
+	if escKeyPressed && !is.lastEscKeyPressed && s.IsFullscreen {
+		is.setFullscreen(s, false, "Fullscreen exited with ESC key")
+	}
+
+	is.lastFKeyPressed = fKeyPressed
+	is.lastEscKeyPressed = escKeyPressed
+}
+
+func (is *InputSystem) setFullscreen(s *Shared, fullscreen bool, logMessage string) {
+	s.IsFullscreen = fullscreen
+	ebiten.SetFullscreen(fullscreen)
+	s.NeedsRedraw = true
+	s.Events = append(s.Events, Event{Message: fmt.Sprintf("[%s] %s: %v", s.Elapsed(), logMessage, fullscreen)})
+}
+
+func (is *InputSystem) handleSpeedKeys(w *component.World, s *Shared) {
+	jKeyPressed := ebiten.IsKeyPressed(ebiten.KeyJ)
+	lKeyPressed := ebiten.IsKeyPressed(ebiten.KeyL)
+
+	if jKeyPressed && !is.lastJKeyPressed {
+		is.changeSpeed(w, s, -s.Config.SpeedStep, "decreased")
+	}
+	is.lastJKeyPressed = jKeyPressed
+
+	if lKeyPressed && !is.lastLKeyPressed {
+		is.changeSpeed(w, s, s.Config.SpeedStep, "increased")
+	}
+	is.lastLKeyPressed = lKeyPressed
+}
+
+// changeSpeed adjusts s.Speed by delta within [MinSpeed, MaxSpeed] and
+// rescales every non-dragged entity's velocity to match.
+func (is *InputSystem) changeSpeed(w *component.World, s *Shared, delta float64, verb string) {
+	oldSpeed := s.Speed
+	s.Speed += delta
+	if s.Speed > s.Config.MaxSpeed {
+		s.Speed = s.Config.MaxSpeed
+	}
+	if s.Speed < s.Config.MinSpeed {
+		s.Speed = s.Config.MinSpeed
+	}
+	if s.Speed == oldSpeed {
+		return
+	}
+
+	ratio := s.Speed / oldSpeed
+	for _, id := range w.Entities() {
+		if vel, ok := w.Velocities[id]; ok {
+			vel.VX *= ratio
+			vel.VY *= ratio
+		}
+	}
+
+	s.NeedsRedraw = true
+	s.Events = append(s.Events, Event{Message: fmt.Sprintf("[%s] Speed %s from %.1f to %.1f", s.Elapsed(), verb, oldSpeed, s.Speed)})
+}
+
+func (is *InputSystem) handleMuteKey(s *Shared) {
+	mKeyPressed := ebiten.IsKeyPressed(ebiten.KeyM)
+	if mKeyPressed && !is.lastMKeyPressed {
+		s.Audio.ToggleMute()
+	}
+	is.lastMKeyPressed = mKeyPressed
+}
+
+// handlePauseKey toggles Shared.Paused, which freezes MovementSystem and
+// CollisionSystem in place so Game can stop redrawing every frame.
+func (is *InputSystem) handlePauseKey(s *Shared) {
+	pKeyPressed := ebiten.IsKeyPressed(ebiten.KeyP)
+	if pKeyPressed && !is.lastPKeyPressed {
+		s.Paused = !s.Paused
+		s.NeedsRedraw = true
+		s.Events = append(s.Events, Event{Message: fmt.Sprintf("[%s] Paused: %v", s.Elapsed(), s.Paused)})
+	}
+	is.lastPKeyPressed = pKeyPressed
+}
+
+// handleMouseInput grabs/drags/flings a logo with the left button and
+// spawns a new logo at the cursor on right click.
+func (is *InputSystem) handleMouseInput(w *component.World, s *Shared) {
+	leftPressed := ebiten.IsMouseButtonPressed(ebiten.MouseButtonLeft)
+	rightPressed := ebiten.IsMouseButtonPressed(ebiten.MouseButtonRight)
+	cx, cy := ebiten.CursorPosition()
+
+	if rightPressed && !is.lastMouseRightPressed {
+		is.spawnLogo(w, s, float64(cx), float64(cy))
+	}
+	is.lastMouseRightPressed = rightPressed
+
+	switch {
+	case leftPressed && !is.lastMouseLeftPressed:
+		if id, ok := is.entityAt(w, float64(cx), float64(cy)); ok {
+			is.startDrag(w, s, id, cx, cy, false, 0)
+		}
+	case leftPressed && is.dragging && !is.draggedByTouch:
+		is.updateDrag(w, s, cx, cy)
+	case !leftPressed && is.dragging && !is.draggedByTouch:
+		is.endDrag(w, s)
+	}
+	is.lastMouseLeftPressed = leftPressed
+}
+
+// handleTouchInput mirrors handleMouseInput's grab/drag/fling behavior
+// for the first touch that starts on top of a logo, so the screensaver
+// is usable on mobile/WASM builds.
+func (is *InputSystem) handleTouchInput(w *component.World, s *Shared) {
+	touchIDs := ebiten.AppendTouchIDs(nil)
+
+	if is.dragging && is.draggedByTouch {
+		for _, id := range touchIDs {
+			if id == is.draggedTouchID {
+				x, y := ebiten.TouchPosition(id)
+				is.updateDrag(w, s, x, y)
+				return
+			}
+		}
+		is.endDrag(w, s)
+		return
+	}
+
+	for _, id := range touchIDs {
+		x, y := ebiten.TouchPosition(id)
+		if entityID, ok := is.entityAt(w, float64(x), float64(y)); ok {
+			is.startDrag(w, s, entityID, x, y, true, id)
+			break
+		}
+	}
+}
+
+// entityAt returns the topmost bouncer entity whose bounding box
+// contains (x, y), searching back-to-front so later-created entities
+// win ties.
+func (is *InputSystem) entityAt(w *component.World, x, y float64) (component.EntityID, bool) {
+	ids := w.Entities()
+	for i := len(ids) - 1; i >= 0; i-- {
+		id := ids[i]
+		pos, hasPos := w.Positions[id]
+		bouncer, hasBouncer := w.Bouncers[id]
+		if !hasPos || !hasBouncer {
+			continue
+		}
+		if x >= pos.X && x <= pos.X+float64(bouncer.Width) && y >= pos.Y && y <= pos.Y+float64(bouncer.Height) {
+			return id, true
+		}
+	}
+	return 0, false
+}
+
+// startDrag grabs entity id, suspending its velocity and recording the
+// cursor/touch offset so it tracks pointer movement while held.
+func (is *InputSystem) startDrag(w *component.World, s *Shared, id component.EntityID, px, py int, byTouch bool, touchID ebiten.TouchID) {
+	pos := w.Positions[id]
+	is.dragging = true
+	is.draggedID = id
+	is.draggedByTouch = byTouch
+	is.draggedTouchID = touchID
+	is.dragOffsetX = pos.X - float64(px)
+	is.dragOffsetY = pos.Y - float64(py)
+	is.lastDragX, is.lastDragY = px, py
+	is.dragDeltas = is.dragDeltas[:0]
+
+	if vel, ok := w.Velocities[id]; ok {
+		vel.VX, vel.VY = 0, 0
+	}
+	w.PlayerControlleds[id] = &component.PlayerControlled{}
+	s.NeedsRedraw = true
+}
+
+// updateDrag moves the dragged entity to follow the pointer and records
+// this frame's delta for the eventual fling velocity.
+func (is *InputSystem) updateDrag(w *component.World, s *Shared, px, py int) {
+	pos := w.Positions[is.draggedID]
+	pos.X = float64(px) + is.dragOffsetX
+	pos.Y = float64(py) + is.dragOffsetY
+
+	delta := pointerDelta{dx: float64(px - is.lastDragX), dy: float64(py - is.lastDragY)}
+	is.dragDeltas = append(is.dragDeltas, delta)
+	if len(is.dragDeltas) > dragFlingSampleFrames {
+		is.dragDeltas = is.dragDeltas[1:]
+	}
+	is.lastDragX, is.lastDragY = px, py
+	s.NeedsRedraw = true
+}
+
+// endDrag releases the dragged entity, imparting a velocity equal to
+// the average pointer delta over the last few frames (a "fling").
+func (is *InputSystem) endDrag(w *component.World, s *Shared) {
+	avgX, avgY := is.averageDragDelta()
+	if vel, ok := w.Velocities[is.draggedID]; ok {
+		vel.VX, vel.VY = avgX, avgY
+	}
+	delete(w.PlayerControlleds, is.draggedID)
+
+	is.dragging = false
+	is.draggedByTouch = false
+	is.dragDeltas = is.dragDeltas[:0]
+	s.NeedsRedraw = true
+}
+
+func (is *InputSystem) averageDragDelta() (float64, float64) {
+	if !is.dragging || len(is.dragDeltas) == 0 {
+		return 0, 0
+	}
+	var sumX, sumY float64
+	for _, d := range is.dragDeltas {
+		sumX += d.dx
+		sumY += d.dy
+	}
+	n := float64(len(is.dragDeltas))
+	return sumX / n, sumY / n
+}
+
+// spawnLogo adds a new logo entity centered at (x, y) with a random
+// velocity at the current speed.
+func (is *InputSystem) spawnLogo(w *component.World, s *Shared, x, y float64) {
+	angle := rand.Float64() * 2 * math.Pi
+	vx := math.Cos(angle) * s.Speed
+	vy := math.Sin(angle) * s.Speed
+
+	id := NewLogoEntity(w, x-float64(s.LogoWidth)/2, y-float64(s.LogoHeight)/2, vx, vy, s)
+	s.NeedsRedraw = true
+	s.Events = append(s.Events, Event{Message: fmt.Sprintf(
+		"[%s] Spawned logo entity %d at (%.2f, %.2f) with velocity (%.2f, %.2f)", s.Elapsed(), id, x, y, vx, vy)})
+}
@@ -0,0 +1,81 @@
+package system
+
+import (
+	"testing"
+
+	"github.com/HugeFrog24/dvd-screensaver-go/component"
+)
+
+// These tests drive InputSystem's drag/spawn helpers directly rather than
+// through Update, since Update polls real ebiten key/mouse/touch state
+// that isn't available outside a running game loop.
+
+func TestInputSystemEndDragFlingsAverageVelocity(t *testing.T) {
+	w := component.NewWorld()
+	s := newTestShared()
+	id := NewLogoEntity(w, 100, 100, 2, 2, s)
+
+	is := &InputSystem{}
+	is.startDrag(w, s, id, 100, 100, false, 0)
+	if w.Velocities[id].VX != 0 || w.Velocities[id].VY != 0 {
+		t.Fatalf("expected startDrag to zero velocity, got %+v", w.Velocities[id])
+	}
+	if _, dragged := w.PlayerControlleds[id]; !dragged {
+		t.Fatalf("expected startDrag to mark entity as PlayerControlled")
+	}
+
+	is.updateDrag(w, s, 110, 100)
+	is.updateDrag(w, s, 120, 100)
+
+	is.endDrag(w, s)
+
+	vel := w.Velocities[id]
+	if vel.VX != 5 || vel.VY != 0 {
+		t.Fatalf("expected fling velocity averaged from drag deltas (5, 0), got (%v, %v)", vel.VX, vel.VY)
+	}
+	if _, dragged := w.PlayerControlleds[id]; dragged {
+		t.Fatalf("expected endDrag to clear PlayerControlled")
+	}
+	if !s.NeedsRedraw {
+		t.Fatalf("expected endDrag to mark the frame dirty")
+	}
+}
+
+func TestInputSystemSpawnLogoCreatesEntity(t *testing.T) {
+	w := component.NewWorld()
+	s := newTestShared()
+	before := len(w.Entities())
+
+	is := &InputSystem{}
+	is.spawnLogo(w, s, 50, 60)
+
+	ids := w.Entities()
+	if len(ids) != before+1 {
+		t.Fatalf("expected spawnLogo to add one entity, got %d -> %d", before, len(ids))
+	}
+	if !s.NeedsRedraw {
+		t.Fatalf("expected spawnLogo to mark the frame dirty")
+	}
+	if len(s.Events) == 0 {
+		t.Fatalf("expected spawnLogo to log an event")
+	}
+}
+
+func TestInputSystemDraggedEntitySkippedByCollision(t *testing.T) {
+	w := component.NewWorld()
+	s := newTestShared()
+	dragged := NewLogoEntity(w, 100, 100, 2, 0, s)
+	other := NewLogoEntity(w, 110, 100, -2, 0, s)
+
+	is := &InputSystem{}
+	is.startDrag(w, s, dragged, 100, 100, false, 0)
+
+	cs := NewCollisionSystem(s.LogoWidth)
+	if err := cs.Update(w, s); err != nil {
+		t.Fatalf("Update returned error: %v", err)
+	}
+
+	if w.Velocities[other].VX != -2 {
+		t.Fatalf("expected the non-dragged entity to pass through a dragged one untouched, got VX=%v", w.Velocities[other].VX)
+	}
+}
@@ -0,0 +1,25 @@
+package system
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+
+	"github.com/HugeFrog24/dvd-screensaver-go/component"
+)
+
+// LoggingSystem writes every event queued by other systems this tick to
+// Shared.Logger, then clears the queue. Keeping logging as its own
+// system means MovementSystem, CollisionSystem, and InputSystem only
+// need to describe what happened, not how it's recorded.
+type LoggingSystem struct{}
+
+// Update implements System.
+func (LoggingSystem) Update(w *component.World, s *Shared) error {
+	for _, e := range s.Events {
+		s.Logger.Print(e.Message)
+	}
+	s.Events = s.Events[:0]
+	return nil
+}
+
+// Draw implements System; LoggingSystem has nothing to draw.
+func (LoggingSystem) Draw(screen *ebiten.Image, w *component.World, s *Shared) {}
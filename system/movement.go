@@ -0,0 +1,92 @@
+package system
+
+import (
+	"fmt"
+
+	"github.com/hajimehoshi/ebiten/v2"
+
+	"github.com/HugeFrog24/dvd-screensaver-go/audio"
+	"github.com/HugeFrog24/dvd-screensaver-go/component"
+)
+
+// MovementSystem advances every bouncing entity's position each tick and
+// reflects its velocity off the screen edges, cycling its color and
+// playing/queuing a bounce or corner-hit sound and log event on
+// contact. Entities with a PlayerControlled component are skipped since
+// InputSystem drives them instead, and the whole system is skipped
+// while RewindSystem is scrubbing history or the simulation is paused.
+type MovementSystem struct{}
+
+// Update implements System.
+func (MovementSystem) Update(w *component.World, s *Shared) error {
+	if s.RewindActive || s.Paused {
+		return nil
+	}
+
+	// The logo moves essentially every tick, so in practice this is
+	// almost always true; it mainly earns its keep while paused, when
+	// this line simply never runs.
+	s.NeedsRedraw = true
+
+	for _, id := range w.Entities() {
+		if _, dragged := w.PlayerControlleds[id]; dragged {
+			continue
+		}
+		pos, hasPos := w.Positions[id]
+		vel, hasVel := w.Velocities[id]
+		bouncer, hasBouncer := w.Bouncers[id]
+		if !hasPos || !hasVel || !hasBouncer {
+			continue
+		}
+
+		pos.X += vel.VX
+		pos.Y += vel.VY
+
+		var xBounced, yBounced bool
+		var xEdge, yEdge audio.Edge
+
+		if pos.X <= 0 {
+			vel.VX = -vel.VX
+			xBounced, xEdge = true, audio.EdgeLeft
+		} else if pos.X+float64(bouncer.Width) >= float64(s.ScreenWidth) {
+			vel.VX = -vel.VX
+			xBounced, xEdge = true, audio.EdgeRight
+		}
+
+		if pos.Y <= 0 {
+			vel.VY = -vel.VY
+			yBounced, yEdge = true, audio.EdgeTop
+		} else if pos.Y+float64(bouncer.Height) >= float64(s.ScreenHeight) {
+			vel.VY = -vel.VY
+			yBounced, yEdge = true, audio.EdgeBottom
+		}
+
+		if !xBounced && !yBounced {
+			continue
+		}
+		cycleColor(w, id, s)
+
+		switch {
+		case xBounced && yBounced:
+			s.Audio.PlayCornerHit()
+			s.Events = append(s.Events, Event{Kind: EventBounce, Message: fmt.Sprintf(
+				"[%s] BOUNCE: Entity %d hit a corner at position (%.2f, %.2f), new velocity: (%.2f, %.2f)",
+				s.Elapsed(), id, pos.X, pos.Y, vel.VX, vel.VY)})
+		case xBounced:
+			s.Audio.PlayBounce(xEdge)
+			s.Events = append(s.Events, Event{Kind: EventBounce, Message: fmt.Sprintf(
+				"[%s] BOUNCE: Entity %d hit %s edge at position (%.2f, %.2f), new velocity: (%.2f, %.2f)",
+				s.Elapsed(), id, edgeName(xEdge), pos.X, pos.Y, vel.VX, vel.VY)})
+		case yBounced:
+			s.Audio.PlayBounce(yEdge)
+			s.Events = append(s.Events, Event{Kind: EventBounce, Message: fmt.Sprintf(
+				"[%s] BOUNCE: Entity %d hit %s edge at position (%.2f, %.2f), new velocity: (%.2f, %.2f)",
+				s.Elapsed(), id, edgeName(yEdge), pos.X, pos.Y, vel.VX, vel.VY)})
+		}
+	}
+
+	return nil
+}
+
+// Draw implements System; MovementSystem has nothing to draw.
+func (MovementSystem) Draw(screen *ebiten.Image, w *component.World, s *Shared) {}
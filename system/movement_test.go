@@ -0,0 +1,77 @@
+package system
+
+import (
+	"testing"
+
+	"github.com/HugeFrog24/dvd-screensaver-go/component"
+)
+
+func TestMovementSystemBouncesOffLeftEdge(t *testing.T) {
+	w := component.NewWorld()
+	s := newTestShared()
+	id := NewLogoEntity(w, -1, 100, -2, 0, s)
+
+	if err := (MovementSystem{}).Update(w, s); err != nil {
+		t.Fatalf("Update returned error: %v", err)
+	}
+
+	if vel := w.Velocities[id]; vel.VX <= 0 {
+		t.Fatalf("expected velocity to flip positive after a left-edge bounce, got %v", vel.VX)
+	}
+
+	fa := s.Audio.(*fakeAudio)
+	if len(fa.bounces) != 1 {
+		t.Fatalf("expected exactly one bounce sound, got %d", len(fa.bounces))
+	}
+}
+
+func TestMovementSystemPlaysCornerHitOnDoubleBounce(t *testing.T) {
+	w := component.NewWorld()
+	s := newTestShared()
+	NewLogoEntity(w, -1, -1, -2, -2, s)
+
+	if err := (MovementSystem{}).Update(w, s); err != nil {
+		t.Fatalf("Update returned error: %v", err)
+	}
+
+	fa := s.Audio.(*fakeAudio)
+	if fa.cornerHits != 1 {
+		t.Fatalf("expected exactly one corner-hit sound, got %d", fa.cornerHits)
+	}
+	if len(fa.bounces) != 0 {
+		t.Fatalf("expected no edge-bounce sounds on a corner hit, got %d", len(fa.bounces))
+	}
+}
+
+func TestMovementSystemSkipsDraggedEntity(t *testing.T) {
+	w := component.NewWorld()
+	s := newTestShared()
+	id := NewLogoEntity(w, 400, 300, 5, 5, s)
+	w.PlayerControlleds[id] = &component.PlayerControlled{}
+
+	if err := (MovementSystem{}).Update(w, s); err != nil {
+		t.Fatalf("Update returned error: %v", err)
+	}
+
+	pos := w.Positions[id]
+	if pos.X != 400 || pos.Y != 300 {
+		t.Fatalf("expected dragged entity's position to stay fixed, got (%v, %v)", pos.X, pos.Y)
+	}
+}
+
+func TestMovementSystemSkippedDuringRewind(t *testing.T) {
+	w := component.NewWorld()
+	s := newTestShared()
+	s.RewindActive = true
+	NewLogoEntity(w, 400, 300, 5, 5, s)
+
+	if err := (MovementSystem{}).Update(w, s); err != nil {
+		t.Fatalf("Update returned error: %v", err)
+	}
+
+	for _, pos := range w.Positions {
+		if pos.X != 400 || pos.Y != 300 {
+			t.Fatalf("expected position unchanged while rewind is active, got (%v, %v)", pos.X, pos.Y)
+		}
+	}
+}
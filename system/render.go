@@ -0,0 +1,31 @@
+package system
+
+import (
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+
+	"github.com/HugeFrog24/dvd-screensaver-go/component"
+)
+
+// noTint leaves a Sprite's frame colors unchanged; pass to Sprite.Draw
+// for a plain, untinted draw.
+var noTint = color.RGBA{255, 255, 255, 255}
+
+// RenderSystem draws every entity's Renderable sprite at its Position.
+type RenderSystem struct{}
+
+// Update implements System; RenderSystem has nothing to update.
+func (RenderSystem) Update(w *component.World, s *Shared) error { return nil }
+
+// Draw implements System.
+func (RenderSystem) Draw(screen *ebiten.Image, w *component.World, s *Shared) {
+	for _, id := range w.Entities() {
+		pos, hasPos := w.Positions[id]
+		renderable, hasRenderable := w.Renderables[id]
+		if !hasPos || !hasRenderable || renderable.Sprite == nil {
+			continue
+		}
+		renderable.Sprite.Draw(screen, pos.X, pos.Y, noTint)
+	}
+}
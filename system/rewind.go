@@ -0,0 +1,147 @@
+package system
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+
+	"github.com/HugeFrog24/dvd-screensaver-go/component"
+)
+
+// rewindBufferFrames caps the rewind buffer at 30 seconds of history,
+// assuming ebiten's default 60 TPS tick rate.
+const rewindBufferFrames = 30 * 60
+
+// entitySnapshot captures one entity's simulation state for a single
+// rewind buffer frame.
+type entitySnapshot struct {
+	ID         component.EntityID
+	X, Y       float64
+	VX, VY     float64
+	ColorIndex int
+}
+
+// frameSnapshot captures every entity's state for a single tick.
+type frameSnapshot []entitySnapshot
+
+// RewindSystem records every tick's entity state into a bounded buffer
+// and, while R is held, scrubs through it instead of letting
+// MovementSystem/CollisionSystem advance the simulation; left/right
+// arrows move the scrub cursor one frame at a time. Releasing R resumes
+// forward simulation from the scrubbed point, discarding newer buffer
+// entries.
+type RewindSystem struct {
+	buffer []frameSnapshot
+	cursor int
+
+	lastRPressed     bool
+	lastLeftPressed  bool
+	lastRightPressed bool
+}
+
+// NewRewindSystem creates a RewindSystem with an empty history buffer.
+func NewRewindSystem() *RewindSystem {
+	return &RewindSystem{buffer: make([]frameSnapshot, 0, rewindBufferFrames)}
+}
+
+// Update implements System.
+func (rs *RewindSystem) Update(w *component.World, s *Shared) error {
+	rPressed := ebiten.IsKeyPressed(ebiten.KeyR)
+
+	if !rPressed {
+		if rs.lastRPressed && len(rs.buffer) > 0 {
+			// R was just released: resume forward simulation from the
+			// scrubbed point, discarding any newer buffered frames.
+			rs.discardFramesAfter(rs.cursor)
+		}
+		rs.lastRPressed = rPressed
+		rs.record(w)
+
+		s.RewindActive = false
+		s.RewindCursor = rs.cursor
+		s.RewindBufferLen = len(rs.buffer)
+		return nil
+	}
+
+	if !rs.lastRPressed && len(rs.buffer) > 0 {
+		rs.cursor = len(rs.buffer) - 1
+	}
+
+	leftPressed := ebiten.IsKeyPressed(ebiten.KeyLeft)
+	rightPressed := ebiten.IsKeyPressed(ebiten.KeyRight)
+	if leftPressed && !rs.lastLeftPressed && rs.cursor > 0 {
+		rs.cursor--
+	}
+	if rightPressed && !rs.lastRightPressed && rs.cursor < len(rs.buffer)-1 {
+		rs.cursor++
+	}
+	rs.lastLeftPressed = leftPressed
+	rs.lastRightPressed = rightPressed
+
+	if len(rs.buffer) > 0 {
+		rs.apply(w, s, rs.cursor)
+		s.NeedsRedraw = true
+	}
+
+	rs.lastRPressed = rPressed
+	s.RewindActive = true
+	s.RewindCursor = rs.cursor
+	s.RewindBufferLen = len(rs.buffer)
+
+	return nil
+}
+
+// Draw implements System; RewindSystem has nothing to draw (see
+// DebugOverlaySystem for the scrub timeline).
+func (rs *RewindSystem) Draw(screen *ebiten.Image, w *component.World, s *Shared) {}
+
+// record appends the current entity states to the buffer, dropping the
+// oldest frame once it exceeds rewindBufferFrames.
+func (rs *RewindSystem) record(w *component.World) {
+	ids := w.Entities()
+	snap := make(frameSnapshot, 0, len(ids))
+	for _, id := range ids {
+		pos, hasPos := w.Positions[id]
+		vel, hasVel := w.Velocities[id]
+		if !hasPos || !hasVel {
+			continue
+		}
+		colorIndex := 0
+		if cycler, ok := w.ColorCyclers[id]; ok {
+			colorIndex = cycler.Index
+		}
+		snap = append(snap, entitySnapshot{ID: id, X: pos.X, Y: pos.Y, VX: vel.VX, VY: vel.VY, ColorIndex: colorIndex})
+	}
+
+	if len(rs.buffer) >= rewindBufferFrames {
+		rs.buffer = rs.buffer[1:]
+	}
+	rs.buffer = append(rs.buffer, snap)
+}
+
+// discardFramesAfter truncates the buffer to keep only frames up to and
+// including index, dropping any frames scrubbed past but not resumed
+// into.
+func (rs *RewindSystem) discardFramesAfter(index int) {
+	rs.buffer = rs.buffer[:index+1]
+}
+
+// apply restores every recorded entity to the state captured at the
+// given buffer index, regenerating its image only for entities whose
+// recorded color actually changed, since scrubbing holds the cursor
+// still most ticks and regenerating is one GPU texture allocation per
+// entity.
+func (rs *RewindSystem) apply(w *component.World, s *Shared, index int) {
+	for _, es := range rs.buffer[index] {
+		pos, hasPos := w.Positions[es.ID]
+		vel, hasVel := w.Velocities[es.ID]
+		if !hasPos || !hasVel {
+			continue
+		}
+		pos.X, pos.Y = es.X, es.Y
+		vel.VX, vel.VY = es.VX, es.VY
+
+		if cycler, ok := w.ColorCyclers[es.ID]; ok && cycler.Index != es.ColorIndex {
+			cycler.Index = es.ColorIndex
+			setRenderedColor(w, es.ID, s, cycler.Index)
+		}
+	}
+}
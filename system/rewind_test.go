@@ -0,0 +1,66 @@
+package system
+
+import (
+	"testing"
+
+	"github.com/HugeFrog24/dvd-screensaver-go/component"
+)
+
+// These tests drive record/apply/discardFramesAfter directly rather than
+// through Update, since Update polls real ebiten key state that isn't
+// available outside a running game loop.
+
+func TestRewindSystemRecordAppendsSnapshot(t *testing.T) {
+	w := component.NewWorld()
+	s := newTestShared()
+	id := NewLogoEntity(w, 10, 20, 1, 1, s)
+
+	rs := NewRewindSystem()
+	rs.record(w)
+	w.Positions[id].X = 30
+	rs.record(w)
+
+	if len(rs.buffer) != 2 {
+		t.Fatalf("expected 2 recorded frames, got %d", len(rs.buffer))
+	}
+	if rs.buffer[0][0].X != 10 || rs.buffer[1][0].X != 30 {
+		t.Fatalf("expected each recorded frame to capture the position at record time, got %v", rs.buffer)
+	}
+}
+
+func TestRewindSystemApplyRestoresRecordedState(t *testing.T) {
+	w := component.NewWorld()
+	s := newTestShared()
+	id := NewLogoEntity(w, 10, 20, 1, 1, s)
+
+	rs := NewRewindSystem()
+	rs.record(w) // frame 0: (10, 20)
+	w.Positions[id].X, w.Positions[id].Y = 50, 60
+	rs.record(w) // frame 1: (50, 60)
+
+	rs.apply(w, s, 0)
+
+	pos := w.Positions[id]
+	if pos.X != 10 || pos.Y != 20 {
+		t.Fatalf("expected apply(0) to restore the first recorded position, got (%v, %v)", pos.X, pos.Y)
+	}
+}
+
+func TestRewindSystemResumeDiscardsFutureFrames(t *testing.T) {
+	w := component.NewWorld()
+	s := newTestShared()
+	NewLogoEntity(w, 0, 0, 1, 1, s)
+
+	rs := NewRewindSystem()
+	for i := 0; i < 5; i++ {
+		rs.record(w)
+	}
+
+	// Scrub back to frame 2, then resume: frames 3 and 4 should be gone.
+	rs.cursor = 2
+	rs.discardFramesAfter(rs.cursor)
+
+	if len(rs.buffer) != 3 {
+		t.Fatalf("expected resuming from cursor 2 to discard later frames, leaving 3, got %d", len(rs.buffer))
+	}
+}
@@ -0,0 +1,72 @@
+package system
+
+import "github.com/HugeFrog24/dvd-screensaver-go/component"
+
+// spatialGrid is a uniform-grid spatial hash used by CollisionSystem to
+// find candidate collision pairs in roughly O(N) time instead of
+// O(N^2). Cells are sized to the logo width, so any two overlapping
+// logos are always found in the same cell or an adjacent one.
+type spatialGrid struct {
+	cellSize int
+	cells    map[[2]int][]int // cell coordinates -> indices into the slice passed to rebuild
+}
+
+func newSpatialGrid(cellSize int) *spatialGrid {
+	return &spatialGrid{
+		cellSize: cellSize,
+		cells:    make(map[[2]int][]int),
+	}
+}
+
+// rebuild clears the grid and re-inserts every entity in ids, keyed by
+// its current Position. Called once per tick before collision
+// detection.
+func (g *spatialGrid) rebuild(w *component.World, ids []component.EntityID) {
+	for k := range g.cells {
+		delete(g.cells, k)
+	}
+	for i, id := range ids {
+		pos := w.Positions[id]
+		key := g.cellKey(pos.X, pos.Y)
+		g.cells[key] = append(g.cells[key], i)
+	}
+}
+
+func (g *spatialGrid) cellKey(x, y float64) [2]int {
+	return [2]int{int(x) / g.cellSize, int(y) / g.cellSize}
+}
+
+// candidatePairs returns index pairs (i < j), indices into the ids slice
+// passed to rebuild, for entities that share a cell or an adjacent cell
+// and therefore need a precise overlap test.
+func (g *spatialGrid) candidatePairs() [][2]int {
+	seen := make(map[[2]int]bool)
+	var pairs [][2]int
+
+	for key, indices := range g.cells {
+		for dx := -1; dx <= 1; dx++ {
+			for dy := -1; dy <= 1; dy++ {
+				neighborKey := [2]int{key[0] + dx, key[1] + dy}
+				neighbors, ok := g.cells[neighborKey]
+				if !ok {
+					continue
+				}
+				for _, i := range indices {
+					for _, j := range neighbors {
+						if i >= j {
+							continue
+						}
+						pairKey := [2]int{i, j}
+						if seen[pairKey] {
+							continue
+						}
+						seen[pairKey] = true
+						pairs = append(pairs, pairKey)
+					}
+				}
+			}
+		}
+	}
+
+	return pairs
+}
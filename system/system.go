@@ -0,0 +1,127 @@
+// Package system holds the systems that operate on a component.World:
+// movement, collision, rendering, input, logging, and the debug
+// overlay. Game.Update and Game.Draw are thin dispatchers that call
+// Update/Draw on every registered System in order; third-party code can
+// register its own via Game.AddSystem to extend the simulation without
+// editing Game.
+package system
+
+import (
+	"image/color"
+	"log"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+
+	"github.com/HugeFrog24/dvd-screensaver-go/audio"
+	"github.com/HugeFrog24/dvd-screensaver-go/component"
+	"github.com/HugeFrog24/dvd-screensaver-go/sprite"
+)
+
+// MaxLogLines is the maximum number of recent log messages kept for the
+// in-app log panel.
+const MaxLogLines = 10
+
+// Config holds configurable simulation parameters shared across systems.
+type Config struct {
+	MinSpeed  float64 // Minimum speed to avoid stopping
+	MaxSpeed  float64 // Maximum speed to avoid chaos
+	SpeedStep float64 // Speed adjustment step
+	LogoCount int     // Number of independent logos to simulate
+}
+
+// LogoRenderer creates the Sprite drawn for a logo of the given size and
+// color. Most implementations bake color into a single generated frame,
+// but nothing stops one from returning a multi-frame animated Sprite.
+type LogoRenderer interface {
+	CreateLogo(width, height int, color color.RGBA) *sprite.Sprite
+}
+
+// AudioPlayer plays bounce/corner-hit sound effects and background
+// music. See the audio package for the default implementation.
+type AudioPlayer interface {
+	PlayBounce(edge audio.Edge)
+	PlayCornerHit()
+	PlayMusic()
+	ToggleMute()
+}
+
+// EventKind distinguishes why an Event was queued, so a future system
+// could filter or route them without parsing Message.
+type EventKind int
+
+const (
+	EventGeneral EventKind = iota
+	EventBounce
+	EventCollision
+)
+
+// Event is something worth logging that a system discovered this tick.
+// Systems append Events to Shared; LoggingSystem drains and writes them,
+// keeping "what happened" separate from "how it's recorded".
+type Event struct {
+	Kind    EventKind
+	Message string
+}
+
+// Shared holds resources and small bits of cross-system state that
+// don't belong to any single entity: injected dependencies,
+// configuration, and UI state multiple systems read or write.
+type Shared struct {
+	Logger    *log.Logger
+	StartTime time.Time
+	Renderer  LogoRenderer
+	Audio     AudioPlayer
+	Colors    []color.RGBA
+	Config    *Config
+
+	ScreenWidth  int
+	ScreenHeight int
+	LogoWidth    int
+	LogoHeight   int
+
+	Speed        float64
+	IsFullscreen bool
+	LogBuffer    []string
+	Events       []Event
+
+	// Paused gates MovementSystem/CollisionSystem like RewindActive: while
+	// true, InputSystem's P key has frozen the simulation in place.
+	Paused bool
+
+	// NeedsRedraw is set by any system that changes what's on screen
+	// (movement, color change, a new log line, fullscreen/speed changes).
+	// Game.Draw clears it after rendering; while it stays false and the
+	// simulation is paused, Game reuses its cached offscreen frame instead
+	// of redrawing.
+	NeedsRedraw bool
+
+	// RewindActive gates MovementSystem/CollisionSystem: while true,
+	// RewindSystem is scrubbing recorded history instead of letting the
+	// simulation advance.
+	RewindActive    bool
+	RewindCursor    int
+	RewindBufferLen int
+
+	// Pointer drag/fling state, written by InputSystem and read by
+	// DebugOverlaySystem to draw the pending fling vector.
+	DragActive bool
+	DragEntity component.EntityID
+	DragAvgDX  float64
+	DragAvgDY  float64
+}
+
+// Elapsed returns the time since the game started, rounded to the
+// millisecond for log readability.
+func (s *Shared) Elapsed() time.Duration {
+	return time.Since(s.StartTime).Round(time.Millisecond)
+}
+
+// System is a self-contained piece of per-tick game logic or rendering.
+// Game.Update and Game.Draw each iterate the registered systems in
+// order, calling Update or Draw respectively; a System that only needs
+// one phase leaves the other method empty.
+type System interface {
+	Update(w *component.World, s *Shared) error
+	Draw(screen *ebiten.Image, w *component.World, s *Shared)
+}